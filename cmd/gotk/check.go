@@ -0,0 +1,222 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check requirements and installation",
+	Long:  "The check command verifies that a Flux toolkit installation is healthy and reports the installed version.",
+	Example: `  # Check if Flux is installed and ready to reconcile
+  gotk check
+`,
+	RunE: checkCmdRun,
+}
+
+var checkKubeVersionConstraint string
+
+func init() {
+	checkCmd.Flags().StringVar(&checkKubeVersionConstraint, "kube-version-constraint", defaultKubernetesVersionConstraint,
+		"the semver constraint that the Kubernetes server version must satisfy")
+	rootCmd.AddCommand(checkCmd)
+}
+
+const clusterInfoConfigMapName = "gotk-cluster-info"
+
+// checkControllerDeployments are the controllers whose readiness and version
+// make up a Flux installation.
+var checkControllerDeployments = []string{
+	"source-controller",
+	"kustomize-controller",
+	"helm-controller",
+	"notification-controller",
+}
+
+// checkRequiredCRDs are the CRDs a Flux instance must register, listed by
+// their fully qualified name.
+var checkRequiredCRDs = []string{
+	"alerts.notification.toolkit.fluxcd.io",
+	"providers.notification.toolkit.fluxcd.io",
+	"receivers.notification.toolkit.fluxcd.io",
+	"kustomizations.kustomize.toolkit.fluxcd.io",
+	"helmreleases.helm.toolkit.fluxcd.io",
+	"gitrepositories.source.toolkit.fluxcd.io",
+	"helmrepositories.source.toolkit.fluxcd.io",
+	"buckets.source.toolkit.fluxcd.io",
+}
+
+func checkCmdRun(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	kubeClient, err := utils.kubeClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	var failed bool
+	var rows [][]string
+
+	if row, err := checkKubernetesVersion(); err != nil {
+		failed = true
+		rows = append(rows, []string{"kubernetes", "failed", err.Error()})
+	} else {
+		rows = append(rows, row)
+	}
+
+	instance, version := checkClusterInfo(ctx, kubeClient)
+	rows = append(rows, []string{"flux instance", instance, version})
+
+	for _, crd := range checkRequiredCRDs {
+		if err := checkCRD(ctx, kubeClient, crd); err != nil {
+			failed = true
+			rows = append(rows, []string{crd, "missing", err.Error()})
+		} else {
+			rows = append(rows, []string{crd, "present", ""})
+		}
+	}
+
+	for _, name := range checkControllerDeployments {
+		logger.Actionf("waiting for %s to be ready", name)
+		if err := wait.PollUntilContextTimeout(ctx, pollInterval, timeout, true,
+			isDeploymentReady(kubeClient, name, namespace)); err != nil {
+			failed = true
+			rows = append(rows, []string{name, "not ready", err.Error()})
+			continue
+		}
+		rows = append(rows, []string{name, "ready", ""})
+	}
+
+	utils.printTable(os.Stdout, []string{"Component", "Status", "Details"}, rows)
+
+	if failed {
+		return fmt.Errorf("check failed")
+	}
+
+	logger.Successf("all checks passed")
+	return nil
+}
+
+// checkKubernetesVersion validates the Kubernetes server version against
+// --kube-version-constraint.
+func checkKubernetesVersion() ([]string, error) {
+	cfg, err := utils.kubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	serverVersion, err := dc.ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get Kubernetes server version: %w", err)
+	}
+
+	v, err := semver.NewVersion(serverVersion.GitVersion)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Kubernetes server version %s: %w", serverVersion.GitVersion, err)
+	}
+
+	constraint, err := semver.NewConstraint(checkKubeVersionConstraint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --kube-version-constraint '%s': %w", checkKubeVersionConstraint, err)
+	}
+
+	if !constraint.Check(v) {
+		return nil, fmt.Errorf("Kubernetes version %s does not satisfy %s", v, checkKubeVersionConstraint)
+	}
+
+	return []string{"kubernetes", "supported", serverVersion.GitVersion}, nil
+}
+
+// defaultKubernetesVersionConstraint is the semver constraint that gotk
+// requires of the target cluster when --kube-version-constraint isn't set.
+const defaultKubernetesVersionConstraint = ">=1.16.0-0"
+
+// checkClusterInfo reports the installed Flux instance name and version,
+// read from the gotk-cluster-info ConfigMap, falling back to the
+// app.kubernetes.io/version label on the controller Deployments when the
+// ConfigMap doesn't exist.
+func checkClusterInfo(ctx context.Context, kubeClient client.Client) (instance, version string) {
+	var cm corev1.ConfigMap
+	namespacedName := types.NamespacedName{Namespace: namespace, Name: clusterInfoConfigMapName}
+	if err := kubeClient.Get(ctx, namespacedName, &cm); err == nil {
+		return cm.Data["instance"], cm.Data["version"]
+	}
+
+	for _, name := range checkControllerDeployments {
+		var deployment appsv1.Deployment
+		if err := kubeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &deployment); err != nil {
+			continue
+		}
+		return namespace, deployment.Labels["app.kubernetes.io/version"]
+	}
+
+	return namespace, "unknown"
+}
+
+// checkCRD reports whether the named CustomResourceDefinition is registered.
+func checkCRD(ctx context.Context, kubeClient client.Client, name string) error {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "apiextensions.k8s.io",
+		Version: "v1",
+		Kind:    "CustomResourceDefinition",
+	})
+
+	if err := kubeClient.Get(ctx, types.NamespacedName{Name: name}, crd); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("CRD %s is not installed", name)
+		}
+		return err
+	}
+	return nil
+}
+
+// isDeploymentReady polls the named Deployment until its ready replica count
+// matches the desired replica count.
+func isDeploymentReady(kubeClient client.Client, name, namespace string) wait.ConditionWithContextFunc {
+	return func(ctx context.Context) (bool, error) {
+		var deployment appsv1.Deployment
+		namespacedName := types.NamespacedName{Namespace: namespace, Name: name}
+		if err := kubeClient.Get(ctx, namespacedName, &deployment); err != nil {
+			return false, err
+		}
+
+		return deployment.Status.ReadyReplicas == *deployment.Spec.Replicas, nil
+	}
+}
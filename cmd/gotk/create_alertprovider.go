@@ -0,0 +1,221 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	notificationv1 "github.com/fluxcd/notification-controller/api/v1beta1"
+
+	"github.com/fluxcd/toolkit/internal/notifier"
+)
+
+var createAlertProviderCmd = &cobra.Command{
+	Use:   "alertprovider [name]",
+	Short: "Create or update a Provider resource",
+	Long:  "The create alertprovider command generates a Provider resource.",
+	Example: `  # Create a Provider for a Slack channel
+  gotk create alertprovider \
+  --type slack \
+  --channel general \
+  --address https://hooks.slack.com/services/token \
+  slack
+
+  # Create a Provider that forwards events as CloudEvents
+  gotk create alertprovider \
+  --type cloudevents \
+  --address https://cloudevents.example.com \
+  cloudevents
+`,
+	RunE: createAlertProviderCmdRun,
+}
+
+// alertProviderTypes are the supported Provider.spec.type values. cloudevents
+// emits CloudEvents v1.0 binary-mode HTTP requests instead of a provider
+// specific payload, so it can be consumed by any CloudEvents broker (e.g.
+// Knative Eventing) without a custom webhook shim.
+var alertProviderTypes = []string{
+	"slack",
+	"discord",
+	"msteams",
+	"rocket",
+	"generic",
+	"github",
+	"gitlab",
+	"cloudevents",
+}
+
+var (
+	apType      string
+	apChannel   string
+	apUsername  string
+	apAddress   string
+	apSecretRef string
+	apTest      bool
+)
+
+func init() {
+	createAlertProviderCmd.Flags().StringVar(&apType, "type", "", "type of provider")
+	createAlertProviderCmd.Flags().StringVar(&apChannel, "channel", "", "channel to send messages to in the case of a chat provider")
+	createAlertProviderCmd.Flags().StringVar(&apUsername, "username", "", "bot username")
+	createAlertProviderCmd.Flags().StringVar(&apAddress, "address", "", "webhook or API address")
+	createAlertProviderCmd.Flags().StringVar(&apSecretRef, "secret-ref", "", "name of secret containing authentication token")
+	createAlertProviderCmd.Flags().BoolVar(&apTest, "test", false, "send a test event to --address after creating the provider (cloudevents only)")
+	createCmd.AddCommand(createAlertProviderCmd)
+}
+
+func createAlertProviderCmdRun(cmd *cobra.Command, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("alertprovider name is required")
+	}
+	name := args[0]
+
+	if !containsFold(alertProviderTypes, apType) {
+		return fmt.Errorf("type '%s' is not supported, must be one of: %v", apType, alertProviderTypes)
+	}
+
+	if apAddress == "" {
+		return fmt.Errorf("address is required")
+	}
+	if _, err := url.ParseRequestURI(apAddress); err != nil {
+		return fmt.Errorf("address must be a valid URL: %w", err)
+	}
+
+	sourceLabels, err := parseLabels()
+	if err != nil {
+		return err
+	}
+
+	if !export {
+		logger.Generatef("generating alertprovider")
+	}
+
+	provider := notificationv1.Provider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    sourceLabels,
+		},
+		Spec: notificationv1.ProviderSpec{
+			Type:     apType,
+			Channel:  apChannel,
+			Username: apUsername,
+			Address:  apAddress,
+		},
+	}
+
+	if apSecretRef != "" {
+		provider.Spec.SecretRef = &corev1.LocalObjectReference{
+			Name: apSecretRef,
+		}
+	}
+
+	if export {
+		return exportAlertProvider(provider)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	kubeClient, err := utils.kubeClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	if err := upsertAndWait(ctx, kubeClient, "alertprovider", &provider, func() error {
+		return upsertAlertProvider(ctx, kubeClient, provider)
+	}); err != nil {
+		return err
+	}
+
+	if apType == "cloudevents" && apTest {
+		if err := sendTestCloudEvent(provider); err != nil {
+			return fmt.Errorf("test event failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// sendTestCloudEvent posts a synthetic event for provider to its address, so
+// operators can confirm a cloudevents Provider is reachable right after
+// creating it, without waiting on a real Alert to fire.
+func sendTestCloudEvent(provider notificationv1.Provider) error {
+	logger.Actionf("sending test event to %s", provider.Spec.Address)
+
+	ce, err := notifier.NewCloudEvent(provider.Spec.Address)
+	if err != nil {
+		return err
+	}
+
+	event := notifier.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Provider",
+			Name:      provider.GetName(),
+			Namespace: provider.GetNamespace(),
+		},
+		Severity:            "info",
+		Reason:              "TestEvent",
+		Message:             fmt.Sprintf("test event from gotk for provider %s", provider.GetName()),
+		ReportingController: "gotk",
+	}
+
+	if err := ce.Post(event); err != nil {
+		return err
+	}
+
+	logger.Successf("test event delivered")
+	return nil
+}
+
+func upsertAlertProvider(ctx context.Context, kubeClient client.Client, provider notificationv1.Provider) error {
+	namespacedName := types.NamespacedName{
+		Namespace: provider.GetNamespace(),
+		Name:      provider.GetName(),
+	}
+
+	var existing notificationv1.Provider
+	err := kubeClient.Get(ctx, namespacedName, &existing)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			if err := kubeClient.Create(ctx, &provider); err != nil {
+				return err
+			}
+			logger.Successf("alertprovider created")
+			return nil
+		}
+		return err
+	}
+
+	existing.Labels = provider.Labels
+	existing.Spec = provider.Spec
+	if err := kubeClient.Update(ctx, &existing); err != nil {
+		return err
+	}
+
+	logger.Successf("alertprovider updated")
+	return nil
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	notificationv1 "github.com/fluxcd/notification-controller/api/v1beta1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+)
+
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Get Kubernetes events of Flux objects",
+	Long:  "The events command prints the Kubernetes events of the given Flux object, or of all objects of a given kind.",
+	Example: `  # List the events for a Kustomization
+  gotk events --for Kustomization/podinfo
+
+  # List the events for all HelmReleases in the namespace
+  gotk events --for HelmRelease
+
+  # Stream Warning events across all namespaces
+  gotk events -A --types Warning --watch
+`,
+	RunE: eventsCmdRun,
+}
+
+var (
+	eventsFor   string
+	eventsTypes []string
+	eventsWatch bool
+)
+
+func init() {
+	eventsCmd.Flags().StringVar(&eventsFor, "for", "", "show events for a single object in the format <kind>/<name>, or all objects of <kind>")
+	eventsCmd.Flags().StringSliceVar(&eventsTypes, "types", []string{}, "filter events by type, e.g. Normal,Warning")
+	eventsCmd.Flags().BoolVar(&eventsWatch, "watch", false, "stream events as they happen")
+	rootCmd.AddCommand(eventsCmd)
+}
+
+// fluxObjectTypes are the Flux kinds --for can resolve against, used only to
+// ask the client's scheme for their canonical GVK.
+var fluxObjectTypes = []client.Object{
+	&notificationv1.Alert{},
+	&notificationv1.Provider{},
+	&notificationv1.Receiver{},
+	&kustomizev1.Kustomization{},
+	&helmv2.HelmRelease{},
+	&sourcev1.GitRepository{},
+	&sourcev1.HelmRepository{},
+	&sourcev1.Bucket{},
+}
+
+// resolveForKind maps kind to its canonical Flux Kind name via the client's
+// scheme, so --for resolves case-insensitively regardless of how the user
+// typed it (e.g. "kustomization/podinfo" and "Kustomization/podinfo").
+func resolveForKind(kubeClient client.Client, kind string) (string, error) {
+	for _, obj := range fluxObjectTypes {
+		gvks, _, err := kubeClient.Scheme().ObjectKinds(obj)
+		if err != nil || len(gvks) == 0 {
+			continue
+		}
+		if strings.EqualFold(gvks[0].Kind, kind) {
+			return gvks[0].Kind, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported --for kind '%s'", kind)
+}
+
+func eventsCmdRun(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	kubeClient, err := utils.kubeClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	var forKind, forName string
+	if eventsFor != "" {
+		kind, name := utils.parseObjectKindName(eventsFor)
+		if kind == "" && !strings.Contains(eventsFor, "/") {
+			kind, name = eventsFor, ""
+		}
+		if kind == "" {
+			return fmt.Errorf("invalid --for '%s', must be in the format <kind>/<name> or <kind>", eventsFor)
+		}
+		forKind, err = resolveForKind(kubeClient, kind)
+		if err != nil {
+			return err
+		}
+		forName = name
+	}
+
+	var listOpts []client.ListOption
+	if !allNamespaces {
+		listOpts = append(listOpts, client.InNamespace(namespace))
+	}
+
+	if eventsWatch {
+		watchClient, ok := kubeClient.(client.WithWatch)
+		if !ok {
+			return fmt.Errorf("--watch is not supported by the current kube client")
+		}
+		return watchEvents(ctx, watchClient, forKind, forName, listOpts)
+	}
+
+	var list corev1.EventList
+	if err := kubeClient.List(ctx, &list, listOpts...); err != nil {
+		return err
+	}
+
+	rows := eventRows(list.Items, forKind, forName)
+	if len(rows) == 0 {
+		logger.Failuref("no events found")
+		return nil
+	}
+
+	header := []string{"Last Seen", "Type", "Reason", "Object", "Message"}
+	if allNamespaces {
+		header = append([]string{"Namespace"}, header...)
+	}
+	utils.printTable(os.Stdout, header, rows)
+	return nil
+}
+
+func watchEvents(ctx context.Context, kubeClient client.WithWatch, forKind, forName string, listOpts []client.ListOption) error {
+	watcher, err := kubeClient.Watch(ctx, &corev1.EventList{}, listOpts...)
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+
+	header := []string{"Last Seen", "Type", "Reason", "Object", "Message"}
+	if allNamespaces {
+		header = append([]string{"Namespace"}, header...)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	for e := range watcher.ResultChan() {
+		event, ok := e.Object.(*corev1.Event)
+		if !ok {
+			continue
+		}
+
+		rows := eventRows([]corev1.Event{*event}, forKind, forName)
+		if len(rows) == 0 {
+			continue
+		}
+
+		for _, row := range rows {
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		w.Flush()
+	}
+	return nil
+}
+
+// eventRows filters events down to those matching forKind/forName and the
+// --types flag, and renders them as table rows.
+func eventRows(events []corev1.Event, forKind, forName string) [][]string {
+	var rows [][]string
+	for _, event := range events {
+		if forKind != "" && !strings.EqualFold(event.InvolvedObject.Kind, forKind) {
+			continue
+		}
+		if forName != "" && event.InvolvedObject.Name != forName {
+			continue
+		}
+		if len(eventsTypes) > 0 && !containsFold(eventsTypes, event.Type) {
+			continue
+		}
+
+		row := []string{
+			translateTimestampSince(event.LastTimestamp.Time),
+			event.Type,
+			event.Reason,
+			fmt.Sprintf("%s/%s", event.InvolvedObject.Kind, event.InvolvedObject.Name),
+			strings.TrimSpace(event.Message),
+		}
+		if allNamespaces {
+			row = append([]string{event.GetNamespace()}, row...)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func containsFold(values []string, target string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func translateTimestampSince(t time.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	return time.Since(t).Round(time.Second).String()
+}
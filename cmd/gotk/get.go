@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	getOutput   string
+	getSelector string
+)
+
+func init() {
+	getCmd.PersistentFlags().StringVarP(&getOutput, "output", "o", "table", "output format: table, json, or yaml")
+	getCmd.PersistentFlags().StringVarP(&getSelector, "selector", "l", "", "label selector to filter objects by")
+}
+
+// rowFunc computes a table row for a single object. The caller is
+// responsible for the Namespace column, which getList prepends itself when
+// --all-namespaces is set.
+type rowFunc func(obj client.Object) []string
+
+// getList lists objects of the kind held by list, filters them by namespace
+// (or all namespaces) and the -l selector, and either prints the list
+// verbatim as JSON/YAML or renders it as a table via toRow. This replaces the
+// near-identical list/filter/render logic that used to be duplicated across
+// every getXxxCmdRun.
+func getList(ctx context.Context, kubeClient client.Client, list client.ObjectList, header []string, toRow rowFunc) error {
+	var listOpts []client.ListOption
+	if !allNamespaces {
+		listOpts = append(listOpts, client.InNamespace(namespace))
+	}
+	if getSelector != "" {
+		selector, err := labels.Parse(getSelector)
+		if err != nil {
+			return fmt.Errorf("invalid selector '%s': %w", getSelector, err)
+		}
+		listOpts = append(listOpts, client.MatchingLabelsSelector{Selector: selector})
+	}
+
+	if err := kubeClient.List(ctx, list, listOpts...); err != nil {
+		return err
+	}
+
+	switch getOutput {
+	case "json":
+		return printList(list, json.MarshalIndent)
+	case "yaml":
+		return printList(list, func(v interface{}, _, _ string) ([]byte, error) {
+			return yaml.Marshal(v)
+		})
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 {
+		logger.Failuref("no objects found in %s namespace", namespace)
+		return nil
+	}
+
+	if allNamespaces {
+		header = append([]string{"Namespace"}, header...)
+	}
+
+	var rows [][]string
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+		row := toRow(obj)
+		if allNamespaces {
+			row = append([]string{obj.GetNamespace()}, row...)
+		}
+		rows = append(rows, row)
+	}
+	utils.printTable(os.Stdout, header, rows)
+	return nil
+}
+
+func printList(list client.ObjectList, marshal func(interface{}, string, string) ([]byte, error)) error {
+	out, err := marshal(list, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stdout, string(out))
+	return nil
+}
+
+// readyStatus derives the Ready column and message for obj from kstatus
+// instead of hand-rolling a meta.GetCondition(..., ReadyCondition) lookup.
+func readyStatus(obj client.Object) (string, string) {
+	res, err := computeStatus(obj)
+	if err != nil {
+		return string(corev1.ConditionFalse), "waiting to be reconciled"
+	}
+
+	switch res.Status {
+	case status.CurrentStatus:
+		return string(corev1.ConditionTrue), res.Message
+	default:
+		return string(corev1.ConditionFalse), res.Message
+	}
+}
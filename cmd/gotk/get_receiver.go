@@ -18,16 +18,13 @@ package main
 
 import (
 	"context"
-	"os"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
-	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	notificationv1 "github.com/fluxcd/notification-controller/api/v1beta1"
-	"github.com/fluxcd/pkg/apis/meta"
 )
 
 var getReceiverCmd = &cobra.Command{
@@ -53,45 +50,16 @@ func getReceiverCmdRun(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	var listOpts []client.ListOption
-	if !allNamespaces {
-		listOpts = append(listOpts, client.InNamespace(namespace))
-	}
 	var list notificationv1.ReceiverList
-	err = kubeClient.List(ctx, &list, listOpts...)
-	if err != nil {
-		return err
-	}
-
-	if len(list.Items) == 0 {
-		logger.Failuref("no receivers found in %s namespace", namespace)
-		return nil
-	}
-
 	header := []string{"Name", "Suspended", "Ready", "Message"}
-	if allNamespaces {
-		header = append([]string{"Namespace"}, header...)
-	}
-	var rows [][]string
-	for _, receiver := range list.Items {
-		row := []string{}
-		if c := meta.GetCondition(receiver.Status.Conditions, meta.ReadyCondition); c != nil {
-			row = []string{
-				receiver.GetName(),
-				strings.Title(strconv.FormatBool(receiver.Spec.Suspend)),
-				string(c.Status),
-				c.Message,
-			}
-		} else {
-			row = []string{
-				receiver.GetName(),
-				strings.Title(strconv.FormatBool(receiver.Spec.Suspend)),
-				string(corev1.ConditionFalse),
-				"waiting to be reconciled",
-			}
+	return getList(ctx, kubeClient, &list, header, func(obj client.Object) []string {
+		receiver := obj.(*notificationv1.Receiver)
+		ready, message := readyStatus(receiver)
+		return []string{
+			receiver.GetName(),
+			strings.Title(strconv.FormatBool(receiver.Spec.Suspend)),
+			ready,
+			message,
 		}
-		rows = append(rows, row)
-	}
-	utils.printTable(os.Stdout, header, rows)
-	return nil
+	})
 }
@@ -0,0 +1,59 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume resources",
+	Long:  "The resume sub-commands resume a suspended resource and trigger a reconciliation.",
+}
+
+func init() {
+	rootCmd.AddCommand(resumeCmd)
+}
+
+// reconcileRequestedAtAnnotation, when set to a timestamp, tells the owning
+// controller to reconcile the object immediately instead of waiting for the
+// next interval.
+const reconcileRequestedAtAnnotation = "reconcile.fluxcd.io/requestedAt"
+
+// resumeObject patches obj's .spec.suspend field to false and annotates it to
+// request an immediate reconciliation, both via a server-side apply patch. It
+// returns the stamped requestedAt timestamp, which the caller must pass to
+// isReconciled so that a stale, pre-suspend Ready condition already sitting
+// in the object's status isn't mistaken for the result of this resume.
+func resumeObject(ctx context.Context, kubeClient client.Client, obj client.Object, apiVersion, kind string) (string, error) {
+	requestedAt := time.Now().Format(time.RFC3339Nano)
+	patch := fmt.Sprintf(`{"apiVersion":%q,"kind":%q,"metadata":{"name":%q,"namespace":%q,"annotations":{%q:%q}},"spec":{"suspend":false}}`,
+		apiVersion, kind, obj.GetName(), obj.GetNamespace(),
+		reconcileRequestedAtAnnotation, requestedAt)
+	if err := kubeClient.Patch(ctx, obj, client.RawPatch(types.ApplyPatchType, []byte(patch)),
+		client.ForceOwnership, client.FieldOwner("gotk")); err != nil {
+		return "", err
+	}
+	return requestedAt, nil
+}
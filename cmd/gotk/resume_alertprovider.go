@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	notificationv1 "github.com/fluxcd/notification-controller/api/v1beta1"
+)
+
+var resumeAlertProviderCmd = &cobra.Command{
+	Use:   "alertprovider [name]",
+	Short: "Resume a suspended Provider",
+	Long:  "The resume alertprovider command re-enables the reconciliation of a suspended Provider resource.",
+	Example: `  # Resume reconciliation for an existing Provider
+  gotk resume alertprovider slack
+`,
+	RunE: resumeAlertProviderCmdRun,
+}
+
+func init() {
+	resumeCmd.AddCommand(resumeAlertProviderCmd)
+}
+
+func resumeAlertProviderCmdRun(cmd *cobra.Command, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("provider name is required")
+	}
+	name := args[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	kubeClient, err := utils.kubeClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	var provider notificationv1.Provider
+	namespacedName := types.NamespacedName{Namespace: namespace, Name: name}
+	if err := kubeClient.Get(ctx, namespacedName, &provider); err != nil {
+		return err
+	}
+
+	logger.Actionf("resuming provider %s in %s namespace", name, namespace)
+	requestedAt, err := resumeObject(ctx, kubeClient, &provider, notificationv1.GroupVersion.String(), "Provider")
+	if err != nil {
+		return err
+	}
+
+	logger.Waitingf("waiting for reconciliation")
+	if err := wait.PollUntilContextTimeout(ctx, pollInterval, timeout, true,
+		isReconciled(kubeClient, namespacedName, &provider, requestedAt)); err != nil {
+		return err
+	}
+
+	logger.Successf("provider %s is ready", name)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	notificationv1 "github.com/fluxcd/notification-controller/api/v1beta1"
+)
+
+var resumeReceiverCmd = &cobra.Command{
+	Use:   "receiver [name]",
+	Short: "Resume a suspended Receiver",
+	Long:  "The resume receiver command re-enables the reconciliation of a suspended Receiver resource.",
+	Example: `  # Resume reconciliation for an existing Receiver
+  gotk resume receiver main
+`,
+	RunE: resumeReceiverCmdRun,
+}
+
+func init() {
+	resumeCmd.AddCommand(resumeReceiverCmd)
+}
+
+func resumeReceiverCmdRun(cmd *cobra.Command, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("receiver name is required")
+	}
+	name := args[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	kubeClient, err := utils.kubeClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	var receiver notificationv1.Receiver
+	namespacedName := types.NamespacedName{Namespace: namespace, Name: name}
+	if err := kubeClient.Get(ctx, namespacedName, &receiver); err != nil {
+		return err
+	}
+
+	logger.Actionf("resuming receiver %s in %s namespace", name, namespace)
+	requestedAt, err := resumeObject(ctx, kubeClient, &receiver, notificationv1.GroupVersion.String(), "Receiver")
+	if err != nil {
+		return err
+	}
+
+	logger.Waitingf("waiting for reconciliation")
+	if err := wait.PollUntilContextTimeout(ctx, pollInterval, timeout, true,
+		isReconciled(kubeClient, namespacedName, &receiver, requestedAt)); err != nil {
+		return err
+	}
+
+	logger.Successf("receiver %s is ready", name)
+
+	return nil
+}
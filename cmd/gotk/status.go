@@ -0,0 +1,127 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// computeStatus converts obj to unstructured and runs it through
+// kstatus.Compute, which only operates on *unstructured.Unstructured.
+func computeStatus(obj client.Object) (*status.Result, error) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return status.Compute(&unstructured.Unstructured{Object: m})
+}
+
+// isObjectReady polls obj via kubeClient.Get and derives readiness from its
+// conditions using kstatus.Compute. It replaces the hand-rolled
+// isAlertReady/isProviderReady/isReceiverReady/isKustomizationReady/
+// isHelmReleaseReady/isGitRepositoryReady helpers, which each duplicated the
+// same Get-then-inspect-Ready-condition loop. Objects whose kind carries no
+// status subresource for kstatus to compute (static Flux APIs without
+// conditions) are treated as ready as soon as they can be fetched.
+func isObjectReady(kubeClient client.Client, namespacedName types.NamespacedName, obj client.Object) wait.ConditionWithContextFunc {
+	return func(ctx context.Context) (bool, error) {
+		if err := kubeClient.Get(ctx, namespacedName, obj); err != nil {
+			return false, err
+		}
+
+		res, err := computeStatus(obj)
+		if err != nil {
+			// no status subresource to compute, a successful Get is enough
+			return true, nil
+		}
+
+		switch res.Status {
+		case status.CurrentStatus:
+			return true, nil
+		case status.FailedStatus:
+			return false, fmt.Errorf(res.Message)
+		default:
+			return false, nil
+		}
+	}
+}
+
+// lastHandledReconcileAt reads status.lastHandledReconcileAt from obj, the
+// field Flux controllers set to the reconcileRequestedAtAnnotation value
+// once they have actually acted on a requested reconciliation.
+func lastHandledReconcileAt(obj client.Object) (string, bool) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return "", false
+	}
+	v, found, err := unstructured.NestedString(m, "status", "lastHandledReconcileAt")
+	if err != nil || !found {
+		return "", false
+	}
+	return v, true
+}
+
+// isReconciled polls obj like isObjectReady, but additionally requires
+// status.lastHandledReconcileAt to have caught up with requestedAt before
+// trusting the Ready condition. Without this, an object that was Ready
+// before being suspended still carries that stale Ready condition the
+// instant it's resumed, and a plain isObjectReady poll would report success
+// on its very first Get, before the controller has reconciled anything.
+func isReconciled(kubeClient client.Client, namespacedName types.NamespacedName, obj client.Object, requestedAt string) wait.ConditionWithContextFunc {
+	ready := isObjectReady(kubeClient, namespacedName, obj)
+	return func(ctx context.Context) (bool, error) {
+		ok, err := ready(ctx)
+		if err != nil || !ok {
+			return ok, err
+		}
+
+		if handled, found := lastHandledReconcileAt(obj); !found || handled != requestedAt {
+			return false, nil
+		}
+		return true, nil
+	}
+}
+
+// upsertAndWait applies obj via upsert and then blocks until isObjectReady
+// reports it as reconciled or the root context times out.
+func upsertAndWait(ctx context.Context, kubeClient client.Client, kind string, obj client.Object, upsert func() error) error {
+	logger.Actionf("applying %s", kind)
+	if err := upsert(); err != nil {
+		return err
+	}
+
+	logger.Waitingf("waiting for reconciliation")
+	namespacedName := types.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+	if err := wait.PollUntilContextTimeout(ctx, pollInterval, timeout, true,
+		isObjectReady(kubeClient, namespacedName, obj)); err != nil {
+		return err
+	}
+
+	logger.Successf("%s %s is ready", kind, obj.GetName())
+	return nil
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var suspendCmd = &cobra.Command{
+	Use:   "suspend",
+	Short: "Suspend resources",
+	Long:  "The suspend sub-commands suspend the reconciliation of a resource.",
+}
+
+func init() {
+	rootCmd.AddCommand(suspendCmd)
+}
+
+// suspendObject patches obj's .spec.suspend field to true via a server-side
+// apply patch, so suspending an object never clobbers fields owned by the
+// controller that manages it.
+func suspendObject(ctx context.Context, kubeClient client.Client, obj client.Object, apiVersion, kind string) error {
+	patch := fmt.Sprintf(`{"apiVersion":%q,"kind":%q,"metadata":{"name":%q,"namespace":%q},"spec":{"suspend":true}}`,
+		apiVersion, kind, obj.GetName(), obj.GetNamespace())
+	return kubeClient.Patch(ctx, obj, client.RawPatch(types.ApplyPatchType, []byte(patch)),
+		client.ForceOwnership, client.FieldOwner("gotk"))
+}
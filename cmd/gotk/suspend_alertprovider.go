@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+
+	notificationv1 "github.com/fluxcd/notification-controller/api/v1beta1"
+)
+
+var suspendAlertProviderCmd = &cobra.Command{
+	Use:   "alertprovider [name]",
+	Short: "Suspend reconciliation of a Provider",
+	Long:  "The suspend alertprovider command disables the reconciliation of a Provider resource.",
+	Example: `  # Suspend reconciliation for an existing Provider
+  gotk suspend alertprovider slack
+`,
+	RunE: suspendAlertProviderCmdRun,
+}
+
+func init() {
+	suspendCmd.AddCommand(suspendAlertProviderCmd)
+}
+
+func suspendAlertProviderCmdRun(cmd *cobra.Command, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("provider name is required")
+	}
+	name := args[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	kubeClient, err := utils.kubeClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	var provider notificationv1.Provider
+	namespacedName := types.NamespacedName{Namespace: namespace, Name: name}
+	if err := kubeClient.Get(ctx, namespacedName, &provider); err != nil {
+		return err
+	}
+
+	logger.Actionf("suspending provider %s in %s namespace", name, namespace)
+	if err := suspendObject(ctx, kubeClient, &provider, notificationv1.GroupVersion.String(), "Provider"); err != nil {
+		return err
+	}
+	logger.Successf("provider suspended")
+
+	return nil
+}
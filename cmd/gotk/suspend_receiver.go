@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+
+	notificationv1 "github.com/fluxcd/notification-controller/api/v1beta1"
+)
+
+var suspendReceiverCmd = &cobra.Command{
+	Use:   "receiver [name]",
+	Short: "Suspend reconciliation of a Receiver",
+	Long:  "The suspend receiver command disables the reconciliation of a Receiver resource.",
+	Example: `  # Suspend reconciliation for an existing Receiver
+  gotk suspend receiver main
+`,
+	RunE: suspendReceiverCmdRun,
+}
+
+func init() {
+	suspendCmd.AddCommand(suspendReceiverCmd)
+}
+
+func suspendReceiverCmdRun(cmd *cobra.Command, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("receiver name is required")
+	}
+	name := args[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	kubeClient, err := utils.kubeClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	var receiver notificationv1.Receiver
+	namespacedName := types.NamespacedName{Namespace: namespace, Name: name}
+	if err := kubeClient.Get(ctx, namespacedName, &receiver); err != nil {
+		return err
+	}
+
+	logger.Actionf("suspending receiver %s in %s namespace", name, namespace)
+	if err := suspendObject(ctx, kubeClient, &receiver, notificationv1.GroupVersion.String(), "Receiver"); err != nil {
+		return err
+	}
+	logger.Successf("receiver suspended")
+
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// CloudEvent forwards Flux events as CloudEvents v1.0 binary-mode HTTP
+// requests, so any CloudEvents broker (e.g. Knative Eventing) can consume
+// Flux notifications without a custom webhook shim.
+type CloudEvent struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewCloudEvent creates a CloudEvent provider that posts to address.
+func NewCloudEvent(address string) (*CloudEvent, error) {
+	if address == "" {
+		return nil, fmt.Errorf("address cannot be empty")
+	}
+
+	return &CloudEvent{
+		URL:    address,
+		Client: &http.Client{Timeout: 15 * time.Second},
+	}, nil
+}
+
+// Post sends event to the CloudEvent address using binary content mode, i.e.
+// the event attributes are carried as ce-* HTTP headers and the event itself
+// is the unmodified request body.
+func (c *CloudEvent) Post(event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to marshal event: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("ce-specversion", "1.0")
+	req.Header.Set("ce-id", newEventID())
+	req.Header.Set("ce-source", event.ReportingController)
+	req.Header.Set("ce-type", fmt.Sprintf("com.fluxcd.notification.%s.%s",
+		strings.ToLower(event.InvolvedObject.Kind), strings.ToLower(event.Reason)))
+	req.Header.Set("ce-subject", fmt.Sprintf("%s/%s", event.InvolvedObject.Namespace, event.InvolvedObject.Name))
+	req.Header.Set("ce-time", time.Now().UTC().Format(time.RFC3339))
+
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting CloudEvent failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("sending CloudEvent failed: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// newEventID returns a random hex-encoded identifier for the ce-id header.
+func newEventID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestCloudEvent_Post(t *testing.T) {
+	var gotHeaders http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	c, err := NewCloudEvent(ts.URL)
+	if err != nil {
+		t.Fatalf("NewCloudEvent() error = %v", err)
+	}
+
+	event := Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Kustomization",
+			Name:      "podinfo",
+			Namespace: "flux-system",
+		},
+		Reason:              "ReconciliationSucceeded",
+		ReportingController: "kustomize-controller",
+	}
+
+	if err := c.Post(event); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+
+	for _, h := range []string{"Ce-Id", "Ce-Source", "Ce-Type", "Ce-Subject", "Ce-Time"} {
+		if gotHeaders.Get(h) == "" {
+			t.Errorf("expected header %s to be set", h)
+		}
+	}
+
+	if want := "com.fluxcd.notification.kustomization.reconciliationsucceeded"; gotHeaders.Get("Ce-Type") != want {
+		t.Errorf("Ce-Type = %s, want %s", gotHeaders.Get("Ce-Type"), want)
+	}
+
+	if want := "flux-system/podinfo"; gotHeaders.Get("Ce-Subject") != want {
+		t.Errorf("Ce-Subject = %s, want %s", gotHeaders.Get("Ce-Subject"), want)
+	}
+
+	if gotHeaders.Get("Ce-Source") != event.ReportingController {
+		t.Errorf("Ce-Source = %s, want %s", gotHeaders.Get("Ce-Source"), event.ReportingController)
+	}
+}
+
+func TestCloudEvent_Post_StatusCodes(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		wantErr    bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusNoContent, false}, // the typical Knative sink ack
+		{http.StatusBadRequest, true},
+		{http.StatusInternalServerError, true},
+	}
+
+	for _, tt := range tests {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.statusCode)
+		}))
+
+		c, err := NewCloudEvent(ts.URL)
+		if err != nil {
+			t.Fatalf("NewCloudEvent() error = %v", err)
+		}
+
+		err = c.Post(Event{InvolvedObject: corev1.ObjectReference{Kind: "Kustomization", Name: "podinfo"}})
+		if (err != nil) != tt.wantErr {
+			t.Errorf("status %d: Post() error = %v, wantErr %v", tt.statusCode, err, tt.wantErr)
+		}
+
+		ts.Close()
+	}
+}